@@ -0,0 +1,108 @@
+package servicebus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/servicebus/mgmt/2021-06-01-preview/servicebus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// waitForAuthorizationRuleConsistency polls `getRule`/`getKeys` until the rule has propagated the
+// given `rights` and both keys have been generated, to avoid a race where downstream resources
+// read `primary_connection_string` before Azure has finished writing it. The lookups are passed in
+// as closures so the helper isn't tied to one client; it's currently called by the queue
+// authorization rule and queue authorization rule key rotation resources.
+func waitForAuthorizationRuleConsistency(ctx context.Context, timeout time.Duration, rights []servicebus.AccessRights, getRule func() (servicebus.SBAuthorizationRule, error), getKeys func() (servicebus.AccessKeys, error)) error {
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:                   []string{"Waiting"},
+		Target:                    []string{"Done"},
+		MinTimeout:                15 * time.Second,
+		ContinuousTargetOccurence: 3,
+		Timeout:                   timeout,
+		Refresh: func() (interface{}, string, error) {
+			rule, err := getRule()
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving authorization rule: %+v", err)
+			}
+
+			keys, err := getKeys()
+			if err != nil {
+				return nil, "", fmt.Errorf("listing keys for authorization rule: %+v", err)
+			}
+
+			rightsMatch := false
+			if props := rule.SBAuthorizationRuleProperties; props != nil {
+				rightsMatch = authorizationRuleRightsMatch(props.Rights, rights)
+			}
+
+			keysReady := keys.PrimaryKey != nil && *keys.PrimaryKey != "" && keys.SecondaryKey != nil && *keys.SecondaryKey != ""
+
+			if !rightsMatch || !keysReady {
+				return "Waiting", "Waiting", nil
+			}
+
+			return "Done", "Done", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for authorization rule to become consistent: %+v", err)
+	}
+
+	return nil
+}
+
+// waitForAuthorizationRuleDeletion polls `getRule` until the authorization rule is reported as
+// not found, so a subsequent create of the same rule doesn't race the delete.
+func waitForAuthorizationRuleDeletion(ctx context.Context, timeout time.Duration, getRule func() (servicebus.SBAuthorizationRule, error)) error {
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:                   []string{"Waiting"},
+		Target:                    []string{"NotFound"},
+		MinTimeout:                15 * time.Second,
+		ContinuousTargetOccurence: 3,
+		Timeout:                   timeout,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := getRule()
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return "NotFound", "NotFound", nil
+				}
+
+				return nil, "", fmt.Errorf("retrieving authorization rule: %+v", err)
+			}
+
+			return resp, "Waiting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for authorization rule deletion: %+v", err)
+	}
+
+	return nil
+}
+
+func authorizationRuleRightsMatch(got *[]servicebus.AccessRights, want []servicebus.AccessRights) bool {
+	if got == nil || len(*got) != len(want) {
+		return false
+	}
+
+	for _, w := range want {
+		found := false
+		for _, g := range *got {
+			if g == w {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,35 @@
+package servicebus
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "ServiceBus"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Messaging",
+	}
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{
+		"azurerm_servicebus_queue_authorization_rule_sas": dataSourceServiceBusQueueAuthorizationRuleSas(),
+	}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{
+		"azurerm_servicebus_queue_authorization_rule":              resourceServiceBusQueueAuthorizationRule(),
+		"azurerm_servicebus_queue_authorization_rule_key_rotation": resourceServiceBusQueueAuthorizationRuleKeyRotation(),
+		"azurerm_servicebus_queue_authorization_rules":             resourceServiceBusQueueAuthorizationRules(),
+	}
+}
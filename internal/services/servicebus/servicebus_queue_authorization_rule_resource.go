@@ -86,10 +86,11 @@ func resourceServiceBusQueueAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceD
 		}
 	}
 
+	rights := expandAuthorizationRuleRights(d)
 	parameters := servicebus.SBAuthorizationRule{
 		Name: utils.String(resourceId.AuthorizationRuleName),
 		SBAuthorizationRuleProperties: &servicebus.SBAuthorizationRuleProperties{
-			Rights: expandAuthorizationRuleRights(d),
+			Rights: rights,
 		},
 	}
 
@@ -103,6 +104,19 @@ func resourceServiceBusQueueAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceD
 		return fmt.Errorf("waiting for replication to complete for Service Bus Namespace Disaster Recovery Configs (Namespace %q / Resource Group %q): %s", resourceId.NamespaceName, resourceId.ResourceGroup, err)
 	}
 
+	timeout := d.Timeout(pluginsdk.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(pluginsdk.TimeoutCreate)
+	}
+
+	if err := waitForAuthorizationRuleConsistency(ctx, timeout, *rights, func() (servicebus.SBAuthorizationRule, error) {
+		return client.GetAuthorizationRule(ctx, resourceId.ResourceGroup, resourceId.NamespaceName, resourceId.QueueName, resourceId.AuthorizationRuleName)
+	}, func() (servicebus.AccessKeys, error) {
+		return client.ListKeys(ctx, resourceId.ResourceGroup, resourceId.NamespaceName, resourceId.QueueName, resourceId.AuthorizationRuleName)
+	}); err != nil {
+		return fmt.Errorf("waiting for %s to become consistent: %+v", resourceId, err)
+	}
+
 	return resourceServiceBusQueueAuthorizationRuleRead(d, meta)
 }
 
@@ -171,5 +185,11 @@ func resourceServiceBusQueueAuthorizationRuleDelete(d *pluginsdk.ResourceData, m
 		return fmt.Errorf("waiting for replication to complete for Service Bus Namespace Disaster Recovery Configs (Namespace %q / Resource Group %q): %s", id.NamespaceName, id.ResourceGroup, err)
 	}
 
+	if err := waitForAuthorizationRuleDeletion(ctx, d.Timeout(pluginsdk.TimeoutDelete), func() (servicebus.SBAuthorizationRule, error) {
+		return client.GetAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.QueueName, id.AuthorizationRuleName)
+	}); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+	}
+
 	return nil
 }
@@ -0,0 +1,140 @@
+package servicebus
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceServiceBusQueueAuthorizationRuleSas() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceServiceBusQueueAuthorizationRuleSasRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"authorization_rule_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.QueueAuthorizationRuleID,
+			},
+
+			"resource_uri": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"validity": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "1h",
+				ValidateFunc: validateRotationInterval,
+			},
+
+			"key_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "primary",
+				ValidateFunc: validation.StringInSlice([]string{
+					"primary",
+					"secondary",
+				}, false),
+			},
+
+			"sas_token": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"sas_url": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"expiry": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceServiceBusQueueAuthorizationRuleSasRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.QueuesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	ruleId, err := parse.QueueAuthorizationRuleID(d.Get("authorization_rule_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keysResp, err := client.ListKeys(ctx, ruleId.ResourceGroup, ruleId.NamespaceName, ruleId.QueueName, ruleId.AuthorizationRuleName)
+	if err != nil {
+		return fmt.Errorf("listing keys for %s: %+v", ruleId, err)
+	}
+
+	key := keysResp.PrimaryKey
+	if d.Get("key_type").(string) == "secondary" {
+		key = keysResp.SecondaryKey
+	}
+	if key == nil || *key == "" {
+		return fmt.Errorf("retrieving %s key for %s: key was empty", d.Get("key_type").(string), ruleId)
+	}
+
+	resourceUri := d.Get("resource_uri").(string)
+	if resourceUri == "" {
+		resourceUri = fmt.Sprintf("https://%s.servicebus.windows.net/%s", ruleId.NamespaceName, ruleId.QueueName)
+	}
+
+	validity, err := time.ParseDuration(d.Get("validity").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `validity`: %+v", err)
+	}
+
+	expiry := time.Now().Add(validity).Unix()
+
+	sasToken, err := buildServiceBusSasToken(resourceUri, *key, ruleId.AuthorizationRuleName, expiry)
+	if err != nil {
+		return fmt.Errorf("building SAS token for %s: %+v", ruleId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/sas-%d", ruleId.ID(), expiry))
+	d.Set("resource_uri", resourceUri)
+	d.Set("sas_token", sasToken)
+	d.Set("sas_url", fmt.Sprintf("%s?%s", resourceUri, sasToken))
+	d.Set("expiry", int(expiry))
+
+	return nil
+}
+
+// buildServiceBusSasToken computes a Shared Access Signature token for `resourceUri` in the shape
+// Service Bus expects: `SharedAccessSignature sr={uri}&sig={signature}&se={expiry}&skn={keyName}`.
+func buildServiceBusSasToken(resourceUri, key, keyName string, expiry int64) (string, error) {
+	encodedUri := url.QueryEscape(resourceUri)
+	stringToSign := fmt.Sprintf("%s\n%d", encodedUri, expiry)
+
+	mac := hmac.New(sha256.New, []byte(key))
+	if _, err := mac.Write([]byte(stringToSign)); err != nil {
+		return "", err
+	}
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return fmt.Sprintf("SharedAccessSignature sr=%s&sig=%s&se=%s&skn=%s", encodedUri, url.QueryEscape(signature), strconv.FormatInt(expiry, 10), url.QueryEscape(keyName)), nil
+}
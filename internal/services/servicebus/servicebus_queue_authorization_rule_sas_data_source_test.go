@@ -0,0 +1,65 @@
+package servicebus_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ServiceBusQueueAuthorizationRuleSasDataSource struct{}
+
+func TestAccServiceBusQueueAuthorizationRuleSasDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_servicebus_queue_authorization_rule_sas", "test")
+	r := ServiceBusQueueAuthorizationRuleSasDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sas_token").Exists(),
+				check.That(data.ResourceName).Key("sas_url").Exists(),
+				check.That(data.ResourceName).Key("expiry").Exists(),
+			),
+		},
+	})
+}
+
+func (r ServiceBusQueueAuthorizationRuleSasDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-servicebus-%d"
+  location = "%s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestservicebusnamespace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_servicebus_queue" "test" {
+  name         = "acctestservicebusqueue-%d"
+  namespace_id = azurerm_servicebus_namespace.test.id
+}
+
+resource "azurerm_servicebus_queue_authorization_rule" "test" {
+  name     = "acctestservicebusqueueauthrule-%d"
+  queue_id = azurerm_servicebus_queue.test.id
+
+  listen = true
+}
+
+data "azurerm_servicebus_queue_authorization_rule_sas" "test" {
+  authorization_rule_id = azurerm_servicebus_queue_authorization_rule.test.id
+  validity              = "1h"
+  key_type              = "primary"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,399 @@
+package servicebus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/servicebus/mgmt/2021-06-01-preview/servicebus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// queueAuthorizationRulesWorkerCount bounds how many CreateOrUpdate/Delete calls are in flight at
+// once, so a queue with dozens of per-tenant rules doesn't open dozens of simultaneous ARM calls.
+const queueAuthorizationRulesWorkerCount = 8
+
+func resourceServiceBusQueueAuthorizationRules() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceServiceBusQueueAuthorizationRulesCreateUpdate,
+		Read:   resourceServiceBusQueueAuthorizationRulesRead,
+		Update: resourceServiceBusQueueAuthorizationRulesCreateUpdate,
+		Delete: resourceServiceBusQueueAuthorizationRulesDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.QueueID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(queueAuthorizationRulesCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"queue_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.QueueID,
+			},
+
+			"rule": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.AuthorizationRuleName(),
+						},
+
+						"listen": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"send": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"manage": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"primary_keys": {
+				Type:      pluginsdk.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &pluginsdk.Schema{Type: pluginsdk.TypeString},
+			},
+
+			"primary_connection_strings": {
+				Type:      pluginsdk.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &pluginsdk.Schema{Type: pluginsdk.TypeString},
+			},
+
+			"secondary_keys": {
+				Type:      pluginsdk.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &pluginsdk.Schema{Type: pluginsdk.TypeString},
+			},
+
+			"secondary_connection_strings": {
+				Type:      pluginsdk.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &pluginsdk.Schema{Type: pluginsdk.TypeString},
+			},
+		},
+	}
+}
+
+type queueAuthorizationRuleDiffEntry struct {
+	name   string
+	rights []servicebus.AccessRights
+}
+
+func resourceServiceBusQueueAuthorizationRulesCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.QueuesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for ServiceBus Queue Authorization Rules creation.")
+
+	queueId, err := parse.QueueID(d.Get("queue_id").(string))
+	if err != nil {
+		return err
+	}
+
+	desired := map[string][]servicebus.AccessRights{}
+	for _, raw := range d.Get("rule").(*pluginsdk.Set).List() {
+		rule := raw.(map[string]interface{})
+		desired[rule["name"].(string)] = expandQueueAuthorizationRuleSetRights(rule)
+	}
+
+	existing, found, err := listQueueAuthorizationRules(ctx, client, queueId)
+	if err != nil {
+		return fmt.Errorf("listing existing authorization rules for %s: %+v", queueId, err)
+	}
+	if !found {
+		return fmt.Errorf("%s was not found", queueId)
+	}
+
+	var toUpsert []queueAuthorizationRuleDiffEntry
+	for name, rights := range desired {
+		current, ok := existing[name]
+		if !ok || !authorizationRuleRightsMatch(&current, rights) {
+			toUpsert = append(toUpsert, queueAuthorizationRuleDiffEntry{name: name, rights: rights})
+		}
+	}
+
+	var toDelete []string
+	for name := range existing {
+		if _, ok := desired[name]; !ok {
+			toDelete = append(toDelete, name)
+		}
+	}
+
+	if err := runQueueAuthorizationRuleTasks(toUpsert, func(entry queueAuthorizationRuleDiffEntry) error {
+		parameters := servicebus.SBAuthorizationRule{
+			Name: utils.String(entry.name),
+			SBAuthorizationRuleProperties: &servicebus.SBAuthorizationRuleProperties{
+				Rights: &entry.rights,
+			},
+		}
+
+		if _, err := client.CreateOrUpdateAuthorizationRule(ctx, queueId.ResourceGroup, queueId.NamespaceName, queueId.Name, entry.name, parameters); err != nil {
+			return fmt.Errorf("creating/updating authorization rule %q: %+v", entry.name, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := runQueueAuthorizationRuleTasks(toDeleteEntries(toDelete), func(entry queueAuthorizationRuleDiffEntry) error {
+		if _, err := client.DeleteAuthorizationRule(ctx, queueId.ResourceGroup, queueId.NamespaceName, queueId.Name, entry.name); err != nil {
+			return fmt.Errorf("deleting authorization rule %q: %+v", entry.name, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	d.SetId(queueId.ID())
+
+	if err := waitForPairedNamespaceReplication(ctx, meta, queueId.ResourceGroup, queueId.NamespaceName, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("waiting for replication to complete for Service Bus Namespace Disaster Recovery Configs (Namespace %q / Resource Group %q): %s", queueId.NamespaceName, queueId.ResourceGroup, err)
+	}
+
+	return resourceServiceBusQueueAuthorizationRulesRead(d, meta)
+}
+
+func resourceServiceBusQueueAuthorizationRulesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.QueuesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	queueId, err := parse.QueueID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rules, found, err := listQueueAuthorizationRules(ctx, client, queueId)
+	if err != nil {
+		return fmt.Errorf("listing authorization rules for %s: %+v", queueId, err)
+	}
+	if !found {
+		log.Printf("[INFO] %s was not found - removing from state", queueId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("queue_id", queueId.ID())
+
+	ruleBlocks := make([]interface{}, 0, len(rules))
+	primaryKeys := map[string]interface{}{}
+	primaryConnectionStrings := map[string]interface{}{}
+	secondaryKeys := map[string]interface{}{}
+	secondaryConnectionStrings := map[string]interface{}{}
+
+	for name, rights := range rules {
+		listen, send, manage := flattenAuthorizationRuleRights(&rights)
+		ruleBlocks = append(ruleBlocks, map[string]interface{}{
+			"name":   name,
+			"listen": listen,
+			"send":   send,
+			"manage": manage,
+		})
+
+		keysResp, err := client.ListKeys(ctx, queueId.ResourceGroup, queueId.NamespaceName, queueId.Name, name)
+		if err != nil {
+			return fmt.Errorf("listing keys for authorization rule %q: %+v", name, err)
+		}
+
+		primaryKeys[name] = utils.NormalizeNilableString(keysResp.PrimaryKey)
+		primaryConnectionStrings[name] = utils.NormalizeNilableString(keysResp.PrimaryConnectionString)
+		secondaryKeys[name] = utils.NormalizeNilableString(keysResp.SecondaryKey)
+		secondaryConnectionStrings[name] = utils.NormalizeNilableString(keysResp.SecondaryConnectionString)
+	}
+
+	d.Set("rule", ruleBlocks)
+	d.Set("primary_keys", primaryKeys)
+	d.Set("primary_connection_strings", primaryConnectionStrings)
+	d.Set("secondary_keys", secondaryKeys)
+	d.Set("secondary_connection_strings", secondaryConnectionStrings)
+
+	return nil
+}
+
+func resourceServiceBusQueueAuthorizationRulesDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.QueuesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	queueId, err := parse.QueueID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, found, err := listQueueAuthorizationRules(ctx, client, queueId)
+	if err != nil {
+		return fmt.Errorf("listing existing authorization rules for %s: %+v", queueId, err)
+	}
+	if !found {
+		return nil
+	}
+
+	names := make([]string, 0, len(existing))
+	for name := range existing {
+		names = append(names, name)
+	}
+
+	if err := runQueueAuthorizationRuleTasks(toDeleteEntries(names), func(entry queueAuthorizationRuleDiffEntry) error {
+		if _, err := client.DeleteAuthorizationRule(ctx, queueId.ResourceGroup, queueId.NamespaceName, queueId.Name, entry.name); err != nil {
+			return fmt.Errorf("deleting authorization rule %q: %+v", entry.name, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := waitForPairedNamespaceReplication(ctx, meta, queueId.ResourceGroup, queueId.NamespaceName, d.Timeout(pluginsdk.TimeoutDelete)); err != nil {
+		return fmt.Errorf("waiting for replication to complete for Service Bus Namespace Disaster Recovery Configs (Namespace %q / Resource Group %q): %s", queueId.NamespaceName, queueId.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+// listQueueAuthorizationRules pages through every authorization rule on the queue with a single
+// `ListAuthorizationRules` call, returning the rights keyed by rule name. The second return value
+// is false when the parent queue itself could not be found, so callers can remove the resource
+// from state instead of failing the plan.
+func listQueueAuthorizationRules(ctx context.Context, client *servicebus.QueuesClient, queueId *parse.QueueId) (map[string][]servicebus.AccessRights, bool, error) {
+	result := map[string][]servicebus.AccessRights{}
+
+	iterator, err := client.ListAuthorizationRulesComplete(ctx, queueId.ResourceGroup, queueId.NamespaceName, queueId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(iterator.Response().Response) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	for iterator.NotDone() {
+		rule := iterator.Value()
+		if rule.Name != nil && rule.SBAuthorizationRuleProperties != nil && rule.Rights != nil {
+			result[*rule.Name] = *rule.Rights
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			if utils.ResponseWasNotFound(iterator.Response().Response) {
+				return nil, false, nil
+			}
+
+			return nil, false, err
+		}
+	}
+
+	return result, true, nil
+}
+
+// runQueueAuthorizationRuleTasks fans `task` out across a bounded worker pool so a queue with
+// dozens of rules doesn't serialize one ARM call after another.
+func runQueueAuthorizationRuleTasks(entries []queueAuthorizationRuleDiffEntry, task func(queueAuthorizationRuleDiffEntry) error) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	sem := make(chan struct{}, queueAuthorizationRulesWorkerCount)
+	errs := make([]error, len(entries))
+
+	var wg sync.WaitGroup
+	for i, entry := range entries {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, entry queueAuthorizationRuleDiffEntry) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			errs[i] = task(entry)
+		}(i, entry)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func toDeleteEntries(names []string) []queueAuthorizationRuleDiffEntry {
+	entries := make([]queueAuthorizationRuleDiffEntry, len(names))
+	for i, name := range names {
+		entries[i] = queueAuthorizationRuleDiffEntry{name: name}
+	}
+
+	return entries
+}
+
+// queueAuthorizationRulesCustomizeDiff mirrors the validation the singular
+// `azurerm_servicebus_queue_authorization_rule` resource applies: Service Bus only accepts a
+// `Manage` claim alongside `Listen` and `Send`, so reject that combination in the plan rather
+// than letting the apply fail against the API.
+func queueAuthorizationRulesCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	for _, raw := range d.Get("rule").(*pluginsdk.Set).List() {
+		rule := raw.(map[string]interface{})
+		if rule["manage"].(bool) && !(rule["listen"].(bool) && rule["send"].(bool)) {
+			return fmt.Errorf("rule %q: `manage` can only be set to `true` when `listen` and `send` are both `true`", rule["name"])
+		}
+	}
+
+	return nil
+}
+
+func expandQueueAuthorizationRuleSetRights(rule map[string]interface{}) []servicebus.AccessRights {
+	rights := make([]servicebus.AccessRights, 0, 3)
+
+	if rule["listen"].(bool) {
+		rights = append(rights, servicebus.Listen)
+	}
+	if rule["send"].(bool) {
+		rights = append(rights, servicebus.Send)
+	}
+	if rule["manage"].(bool) {
+		rights = append(rights, servicebus.Manage)
+	}
+
+	return rights
+}
@@ -0,0 +1,125 @@
+package servicebus_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ServiceBusQueueAuthorizationRulesResource struct{}
+
+func TestAccServiceBusQueueAuthorizationRules_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_queue_authorization_rules", "test")
+	r := ServiceBusQueueAuthorizationRulesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_keys.tenant-a").Exists(),
+				check.That(data.ResourceName).Key("primary_keys.tenant-b").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.addAndRemoveRule(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_keys.tenant-a").Exists(),
+				check.That(data.ResourceName).Key("primary_keys.tenant-c").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ServiceBusQueueAuthorizationRulesResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.QueueID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	iterator, err := clients.ServiceBus.QueuesClient.ListAuthorizationRulesComplete(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(iterator.NotDone()), nil
+}
+
+func (r ServiceBusQueueAuthorizationRulesResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_queue_authorization_rules" "test" {
+  queue_id = azurerm_servicebus_queue.test.id
+
+  rule {
+    name   = "tenant-a"
+    listen = true
+    send   = true
+  }
+
+  rule {
+    name   = "tenant-b"
+    listen = true
+  }
+}
+`, r.template(data))
+}
+
+func (r ServiceBusQueueAuthorizationRulesResource) addAndRemoveRule(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_queue_authorization_rules" "test" {
+  queue_id = azurerm_servicebus_queue.test.id
+
+  rule {
+    name   = "tenant-a"
+    listen = true
+    send   = true
+  }
+
+  rule {
+    name   = "tenant-c"
+    listen = true
+    send   = true
+    manage = true
+  }
+}
+`, r.template(data))
+}
+
+func (r ServiceBusQueueAuthorizationRulesResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-servicebus-%d"
+  location = "%s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestservicebusnamespace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_servicebus_queue" "test" {
+  name         = "acctestservicebusqueue-%d"
+  namespace_id = azurerm_servicebus_namespace.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}